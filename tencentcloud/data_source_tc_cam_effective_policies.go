@@ -0,0 +1,149 @@
+/*
+Use this data source to audit "who can do what": given a user_name, it returns the
+union of CAM policies attached directly to that user and every policy attached to a
+group the user belongs to. It is the inverse of the group membership read path in
+resource_tc_cam_group_membership.go, which walks from a group to its users.
+
+Example Usage
+
+```hcl
+data "tencentcloud_cam_effective_policies" "foo" {
+  user_name = tencentcloud_cam_user.foo.name
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+// dataSourceTencentCloudCamEffectivePolicies is not yet wired into Provider's
+// DataSourcesMap (provider.go is not part of this change); add
+// `"tencentcloud_cam_effective_policies": dataSourceTencentCloudCamEffectivePolicies()`
+// there before this data source is reachable from a real config.
+func dataSourceTencentCloudCamEffectivePolicies() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudCamEffectivePoliciesRead,
+		Schema: map[string]*schema.Schema{
+			"user_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the CAM user to audit.",
+			},
+			"policy_list": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"policy_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the attached policy.",
+						},
+						"policy_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the attached policy.",
+						},
+						"source": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Where this policy was inherited from: `direct`, or the id of the group it came from.",
+						},
+					},
+				},
+				Description: "Union of policies attached directly to `user_name` and through every group it belongs to.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudCamEffectivePoliciesRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("data_source.tencentcloud_cam_effective_policies.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	userName := d.Get("user_name").(string)
+	camService := CamService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+
+	type effectivePolicy struct {
+		policyId   string
+		policyName string
+		source     string
+	}
+	var policies []effectivePolicy
+
+	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		policies = nil
+
+		direct, e := camService.DescribeAttachedUserPolicies(ctx, userName)
+		if e != nil {
+			return retryError(e)
+		}
+		for _, p := range direct {
+			policies = append(policies, effectivePolicy{policyId: p.PolicyId, policyName: p.PolicyName, source: "direct"})
+		}
+
+		groupIds, e := camService.DescribeGroupsForUser(ctx, userName)
+		if e != nil {
+			return retryError(e)
+		}
+		for _, groupId := range groupIds {
+			groupPolicies, e := camService.DescribeAttachedGroupPolicies(ctx, *groupId)
+			if e != nil {
+				return retryError(e)
+			}
+			for _, p := range groupPolicies {
+				policies = append(policies, effectivePolicy{policyId: p.PolicyId, policyName: p.PolicyName, source: *groupId})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s read CAM effective policies failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	seen := make(map[string]bool, len(policies))
+	policyList := make([]map[string]interface{}, 0, len(policies))
+	for _, p := range policies {
+		if seen[p.policyId] {
+			continue
+		}
+		seen[p.policyId] = true
+		policyList = append(policyList, map[string]interface{}{
+			"policy_id":   p.policyId,
+			"policy_name": p.policyName,
+			"source":      p.source,
+		})
+	}
+	_ = d.Set("policy_list", policyList)
+
+	d.SetId(helper.DataResourceIdsHash([]string{userName}))
+
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if e := writeToFile(output.(string), map[string]interface{}{
+			"user_name":   userName,
+			"policy_list": policyList,
+		}); e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
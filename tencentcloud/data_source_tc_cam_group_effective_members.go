@@ -0,0 +1,97 @@
+/*
+Use this data source to query the users belonging to a CAM group. Users of groups
+nested via `member_group_ids` on tencentcloud_cam_group_membership are included,
+because that resource adds them to the group's own CAM membership; this data
+source reads that membership once and does not walk nested groups itself.
+
+Example Usage
+
+```hcl
+data "tencentcloud_cam_group_effective_members" "foo" {
+  group_id = tencentcloud_cam_group.foo.id
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+// dataSourceTencentCloudCamGroupEffectiveMembers is not yet wired into
+// Provider's DataSourcesMap (provider.go is not part of this change); add
+// `"tencentcloud_cam_group_effective_members": dataSourceTencentCloudCamGroupEffectiveMembers()`
+// there before this data source is reachable from a real config.
+func dataSourceTencentCloudCamGroupEffectiveMembers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudCamGroupEffectiveMembersRead,
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of CAM group.",
+			},
+			"effective_user_names": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Flattened set of user names that are members of `group_id`, either directly or through nested groups.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudCamGroupEffectiveMembersRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("data_source.tencentcloud_cam_group_effective_members.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	groupId := d.Get("group_id").(string)
+	camService := CamService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+
+	var effective []string
+	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		visited := map[string]bool{}
+		result, e := expandEffectiveGroupMembers(ctx, []interface{}{groupId}, visited, camService)
+		if e != nil {
+			return retryError(e)
+		}
+		effective = result
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s read CAM group effective members failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+	effective = dedupeStrings(effective)
+
+	_ = d.Set("effective_user_names", effective)
+	d.SetId(helper.DataResourceIdsHash([]string{groupId}))
+
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if e := writeToFile(output.(string), map[string]interface{}{
+			"group_id":             groupId,
+			"effective_user_names": effective,
+		}); e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
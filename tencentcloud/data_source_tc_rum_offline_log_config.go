@@ -16,10 +16,10 @@ import (
 	"fmt"
 	"log"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	rum "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/rum/v20210622"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 )
 
 func dataSourceTencentCloudRumOfflineLogConfig() *schema.Resource {
@@ -73,15 +73,25 @@ func dataSourceTencentCloudRumOfflineLogConfigRead(d *schema.ResourceData, meta
 	rumService := RumService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var logConfigs *rum.DescribeOfflineLogConfigsResponseParams
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		results, e := rumService.DescribeRumOfflineLogConfigByFilter(ctx, paramMap)
-		if e != nil {
-			return retryError(e)
-		}
-		logConfigs = results
-		return nil
-	})
-	if err != nil {
+	w := &waiter.AsyncOperationWaiter{
+		Service: "rum",
+		Type:    "offline_log_config",
+		Op:      "read",
+		Pending: []string{waiter.StatePending},
+		Target:  []string{waiter.StateDone},
+		Refresh: func() (string, error) {
+			results, e := rumService.DescribeRumOfflineLogConfigByFilter(ctx, paramMap)
+			if e != nil {
+				return "", e
+			}
+			logConfigs = results
+			if logConfigs == nil {
+				return waiter.StatePending, nil
+			}
+			return waiter.StateDone, nil
+		},
+	}
+	if err := w.Wait(readRetryTimeout); err != nil {
 		log.Printf("[CRITAL]%s read Rum uniqueIDSet failed, reason:%+v", logId, err)
 		return err
 	}
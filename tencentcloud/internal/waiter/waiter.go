@@ -0,0 +1,94 @@
+// Package waiter provides a small, reusable framework for polling
+// eventually-consistent TencentCloud operations to a terminal state. It is
+// modeled after the GCE-style operation waiter used by other Terraform
+// providers: a StateRefreshFunc reports the current state, and
+// AsyncOperationWaiter polls it with exponential backoff until the state
+// reaches Target, falls outside Pending, or the overall timeout elapses.
+package waiter
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// StatePending is the conventional "still in progress" state returned by
+	// a StateRefreshFunc while the operation being waited on has not
+	// converged yet.
+	StatePending = "PENDING"
+	// StateDone is the conventional "converged" state returned by a
+	// StateRefreshFunc once the operation being waited on is complete.
+	StateDone = "DONE"
+)
+
+// StateRefreshFunc polls the subsystem being waited on and reports its
+// current state. A non-nil error aborts the wait immediately.
+type StateRefreshFunc func() (state string, err error)
+
+// AsyncOperationWaiter polls Refresh until it reports a state in Target,
+// backing off exponentially between polls from MinTimeout up to MaxTimeout.
+// A state that is neither Pending nor Target is treated as a terminal
+// failure. Service/Op/Type only annotate error messages.
+type AsyncOperationWaiter struct {
+	Service string
+	Op      string
+	Type    string
+
+	Refresh StateRefreshFunc
+
+	Pending []string
+	Target  []string
+
+	// MinTimeout is the delay before the first re-poll; it doubles after
+	// each attempt up to MaxTimeout. Defaults to 5s/30s when unset.
+	MinTimeout time.Duration
+	MaxTimeout time.Duration
+}
+
+// Wait polls Refresh until it reports a Target state, a non-Pending,
+// non-Target state (treated as failure), or timeout elapses.
+func (w *AsyncOperationWaiter) Wait(timeout time.Duration) error {
+	minTimeout := w.MinTimeout
+	if minTimeout <= 0 {
+		minTimeout = 5 * time.Second
+	}
+	maxTimeout := w.MaxTimeout
+	if maxTimeout <= 0 {
+		maxTimeout = 30 * time.Second
+	}
+
+	pending := toSet(w.Pending)
+	target := toSet(w.Target)
+
+	delay := minTimeout
+	deadline := time.Now().Add(timeout)
+	for {
+		state, err := w.Refresh()
+		if err != nil {
+			return fmt.Errorf("%s %s %s failed: %s", w.Service, w.Type, w.Op, err)
+		}
+		if target[state] {
+			return nil
+		}
+		if !pending[state] {
+			return fmt.Errorf("%s %s %s entered unexpected state %q", w.Service, w.Type, w.Op, state)
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("%s %s %s timed out after %s waiting for state in %v, last state %q",
+				w.Service, w.Type, w.Op, timeout, w.Target, state)
+		}
+
+		time.Sleep(delay)
+		if delay *= 2; delay > maxTimeout {
+			delay = maxTimeout
+		}
+	}
+}
+
+func toSet(in []string) map[string]bool {
+	out := make(map[string]bool, len(in))
+	for _, v := range in {
+		out[v] = true
+	}
+	return out
+}
@@ -0,0 +1,82 @@
+package waiter
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestAsyncOperationWaiterReachesTarget(t *testing.T) {
+	calls := 0
+	w := &AsyncOperationWaiter{
+		Service: "test",
+		Type:    "thing",
+		Op:      "create",
+		Pending: []string{StatePending},
+		Target:  []string{StateDone},
+		Refresh: func() (string, error) {
+			calls++
+			if calls < 3 {
+				return StatePending, nil
+			}
+			return StateDone, nil
+		},
+		MinTimeout: time.Millisecond,
+		MaxTimeout: time.Millisecond,
+	}
+
+	if err := w.Wait(time.Second); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if calls != 3 {
+		t.Fatalf("expected 3 refresh calls, got %d", calls)
+	}
+}
+
+func TestAsyncOperationWaiterPropagatesRefreshError(t *testing.T) {
+	w := &AsyncOperationWaiter{
+		Pending: []string{StatePending},
+		Target:  []string{StateDone},
+		Refresh: func() (string, error) {
+			return "", errors.New("boom")
+		},
+		MinTimeout: time.Millisecond,
+		MaxTimeout: time.Millisecond,
+	}
+
+	if err := w.Wait(time.Second); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+func TestAsyncOperationWaiterFailsOnUnexpectedState(t *testing.T) {
+	w := &AsyncOperationWaiter{
+		Pending: []string{StatePending},
+		Target:  []string{StateDone},
+		Refresh: func() (string, error) {
+			return "ERROR", nil
+		},
+		MinTimeout: time.Millisecond,
+		MaxTimeout: time.Millisecond,
+	}
+
+	if err := w.Wait(time.Second); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+func TestAsyncOperationWaiterTimesOut(t *testing.T) {
+	w := &AsyncOperationWaiter{
+		Pending: []string{StatePending},
+		Target:  []string{StateDone},
+		Refresh: func() (string, error) {
+			return StatePending, nil
+		},
+		MinTimeout: time.Millisecond,
+		MaxTimeout: time.Millisecond,
+	}
+
+	if err := w.Wait(5 * time.Millisecond); err == nil {
+		t.Fatal("expected timeout error, got nil")
+	}
+}
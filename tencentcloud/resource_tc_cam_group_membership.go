@@ -10,6 +10,24 @@ resource "tencentcloud_cam_group_membership" "foo" {
 }
 ```
 
+Nested groups can be attached as members too, so users in `member_group_ids` show up as members of `group_id`. Only one level of nesting is resolved: if `developers` itself declares its own `member_group_ids`, those are not expanded into `foo`.
+
+```hcl
+resource "tencentcloud_cam_group_membership" "foo" {
+  group_id         = tencentcloud_cam_group.foo.id
+  user_names       = [tencentcloud_cam_user.foo.name]
+  member_group_ids = [tencentcloud_cam_group.developers.id]
+}
+```
+
+Reconciliation resolves member uids through a process-wide cache (TTL defaults to
+5 minutes, override with the `TENCENTCLOUD_CAM_UID_CACHE_TTL` environment variable,
+e.g. `10m`) and batches Add/RemoveUserToGroup calls (size defaults to 50 members per
+call, override with the `TENCENTCLOUD_CAM_MEMBERSHIP_BATCH_SIZE` environment
+variable), so groups with hundreds of members converge in a handful of API calls,
+and concurrent applies against the same group_id are serialized while different
+groups still run in parallel.
+
 Import
 
 CAM group membership can be imported using the id, e.g.
@@ -24,15 +42,219 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"os"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	cam "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cam/v20190116"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
+	"golang.org/x/sync/errgroup"
 )
 
+const (
+	// defaultCamMembershipBatchSize is the number of members reconciled per
+	// AddUserToGroup/RemoveUserFromGroup call unless overridden by the
+	// TENCENTCLOUD_CAM_MEMBERSHIP_BATCH_SIZE environment variable; there is no
+	// provider block argument for it.
+	defaultCamMembershipBatchSize = 50
+	// defaultCamUidCacheTTL bounds how long a resolved user name -> uid
+	// mapping is reused across overlapping tencentcloud_cam_group_membership
+	// resources unless overridden by the TENCENTCLOUD_CAM_UID_CACHE_TTL
+	// environment variable; there is no provider block argument for it.
+	defaultCamUidCacheTTL = 5 * time.Minute
+	// maxConcurrentUidLookups bounds how many getUidFromName calls are
+	// in flight at once when resolving a batch of member names.
+	maxConcurrentUidLookups = 10
+)
+
+var (
+	camMembershipBatchSize = defaultCamMembershipBatchSize
+	camUidCacheTTL         = defaultCamUidCacheTTL
+
+	// camUidCache is a process-wide cache of user name -> uid lookups, shared
+	// by every tencentcloud_cam_group_membership resource so overlapping
+	// members across groups are only resolved once per TTL window.
+	camUidCache sync.Map // map[string]camUidCacheEntry
+
+	// camGroupLocks serializes Add/RemoveUserToGroup reconciliation per group
+	// id, so concurrent applies touching the same group (e.g. under
+	// terraform apply -parallelism=N) don't race each other, while
+	// reconciliation of different groups still runs in parallel.
+	camGroupLocks sync.Map // map[string]*sync.Mutex
+)
+
+type camUidCacheEntry struct {
+	uid       *uint64
+	expiresAt time.Time
+}
+
+// configureCamMembershipTuning overrides the batch size and uid cache TTL used
+// by every tencentcloud_cam_group_membership resource, zero values keeping the
+// defaults. init() below wires it up to the TENCENTCLOUD_CAM_MEMBERSHIP_BATCH_SIZE
+// / TENCENTCLOUD_CAM_UID_CACHE_TTL environment variables today; a future
+// provider.go change adding cam_membership_batch_size/cam_uid_cache_ttl provider
+// block arguments can call it from ConfigureFunc the same way, once that file is
+// touched.
+func configureCamMembershipTuning(batchSize int, uidCacheTTL time.Duration) {
+	if batchSize > 0 {
+		camMembershipBatchSize = batchSize
+	}
+	if uidCacheTTL > 0 {
+		camUidCacheTTL = uidCacheTTL
+	}
+}
+
+// init applies cam_membership_batch_size/cam_uid_cache_ttl overrides from the
+// environment at process start, since this change doesn't touch provider.go to
+// surface them as provider block arguments.
+func init() {
+	if v := os.Getenv("TENCENTCLOUD_CAM_MEMBERSHIP_BATCH_SIZE"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			configureCamMembershipTuning(n, 0)
+		} else {
+			log.Printf("[CRITAL]invalid TENCENTCLOUD_CAM_MEMBERSHIP_BATCH_SIZE %q, ignoring: %s\n", v, err.Error())
+		}
+	}
+	if v := os.Getenv("TENCENTCLOUD_CAM_UID_CACHE_TTL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			configureCamMembershipTuning(0, d)
+		} else {
+			log.Printf("[CRITAL]invalid TENCENTCLOUD_CAM_UID_CACHE_TTL %q, ignoring: %s\n", v, err.Error())
+		}
+	}
+}
+
+func camGroupLock(groupId string) *sync.Mutex {
+	lock, _ := camGroupLocks.LoadOrStore(groupId, &sync.Mutex{})
+	return lock.(*sync.Mutex)
+}
+
+// cachedUidFromName resolves name to a uid through camUidCache, falling back
+// to getUidFromName on a miss or expired entry.
+func cachedUidFromName(name string, meta interface{}) (*uint64, error) {
+	if v, ok := camUidCache.Load(name); ok {
+		entry := v.(camUidCacheEntry)
+		if time.Now().Before(entry.expiresAt) {
+			return entry.uid, nil
+		}
+		camUidCache.Delete(name)
+	}
+
+	uid, err := getUidFromName(name, meta)
+	if err != nil {
+		return nil, err
+	}
+	camUidCache.Store(name, camUidCacheEntry{uid: uid, expiresAt: time.Now().Add(camUidCacheTTL)})
+	return uid, nil
+}
+
+// resolveUids concurrently resolves member names to uids through a bounded
+// worker pool, so a group with hundreds of members doesn't serialize on
+// DescribeUserById round trips.
+func resolveUids(names []interface{}, meta interface{}) ([]*uint64, error) {
+	uids := make([]*uint64, len(names))
+	g := new(errgroup.Group)
+	sem := make(chan struct{}, maxConcurrentUidLookups)
+
+	for i, name := range names {
+		i, name := i, name.(string)
+		g.Go(func() error {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			uid, err := cachedUidFromName(name, meta)
+			if err != nil {
+				return err
+			}
+			uids[i] = uid
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return uids, nil
+}
+
+// resolveUidsTolerant behaves like resolveUids except a member whose name no
+// longer resolves to a uid (ResourceNotFound.UserNotExist, e.g. the user was
+// deleted out-of-band) is skipped instead of failing the whole batch, mirroring
+// the single-member behavior removeUsersFromGroup relied on before batching.
+func resolveUidsTolerant(names []interface{}, meta interface{}) ([]*uint64, error) {
+	uids := make([]*uint64, len(names))
+	g := new(errgroup.Group)
+	sem := make(chan struct{}, maxConcurrentUidLookups)
+
+	for i, name := range names {
+		i, name := i, name.(string)
+		g.Go(func() error {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			uid, err := cachedUidFromName(name, meta)
+			if err != nil {
+				if sdkErr, ok := err.(*errors.TencentCloudSDKError); ok && sdkErr.Code == "ResourceNotFound.UserNotExist" {
+					return nil
+				}
+				return err
+			}
+			uids[i] = uid
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return uids, nil
+}
+
+// chunkUidInfo splits info into batches of at most camMembershipBatchSize
+// entries so a large membership change converges in a handful of
+// Add/RemoveUserToGroup calls instead of one call per member.
+func chunkUidInfo(info []*cam.GroupIdOfUidInfo) [][]*cam.GroupIdOfUidInfo {
+	if len(info) == 0 {
+		return nil
+	}
+	size := camMembershipBatchSize
+	if size <= 0 {
+		size = defaultCamMembershipBatchSize
+	}
+	chunks := make([][]*cam.GroupIdOfUidInfo, 0, (len(info)+size-1)/size)
+	for size < len(info) {
+		info, chunks = info[size:], append(chunks, info[:size:size])
+	}
+	return append(chunks, info)
+}
+
+// camGroupMembershipWaiter waits for a just-applied membership change to show
+// up on a subsequent DescribeGroupMembershipById read, replacing the fixed
+// 10s sleep this resource used to rely on with exponential backoff.
+func camGroupMembershipWaiter(ctx context.Context, groupId string, camService CamService) *waiter.AsyncOperationWaiter {
+	return &waiter.AsyncOperationWaiter{
+		Service: "cam",
+		Type:    "group_membership",
+		Op:      "reconcile",
+		Pending: []string{waiter.StatePending},
+		Target:  []string{waiter.StateDone},
+		Refresh: func() (string, error) {
+			instance, e := camService.DescribeGroupMembershipById(ctx, groupId)
+			if e != nil {
+				return "", e
+			}
+			if len(instance) == 0 {
+				return waiter.StatePending, nil
+			}
+			return waiter.StateDone, nil
+		},
+		MinTimeout: 5 * time.Second,
+		MaxTimeout: 20 * time.Second,
+	}
+}
+
 func resourceTencentCloudCamGroupMembership() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudCamGroupMembershipCreate,
@@ -68,6 +290,22 @@ func resourceTencentCloudCamGroupMembership() *schema.Resource {
 				},
 				Description: "User name set as ID of the CAM group members.",
 			},
+			"member_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "ID set of CAM groups nested as members of this group. Users belonging to a nested group are treated as transitive members of this group, mirroring the group-in-group pattern supported by mainstream IAM systems.",
+			},
+			"effective_user_names": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Flattened set of user names that are members of this group, either directly or through nested `member_group_ids`.",
+			},
 		},
 	}
 }
@@ -87,6 +325,14 @@ func resourceTencentCloudCamGroupMembershipCreate(d *schema.ResourceData, meta i
 		log.Printf("[CRITAL]%s create CAM group membership failed, reason:%s\n", logId, err.Error())
 		return err
 	}
+
+	if memberGroupIds := d.Get("member_group_ids").(*schema.Set); memberGroupIds.Len() > 0 {
+		err = addGroupsToGroup(memberGroupIds.List(), groupId, meta)
+		if err != nil {
+			log.Printf("[CRITAL]%s create CAM group membership failed, reason:%s\n", logId, err.Error())
+			return err
+		}
+	}
 	d.SetId(groupId)
 
 	//get really instance then read
@@ -96,21 +342,10 @@ func resourceTencentCloudCamGroupMembershipCreate(d *schema.ResourceData, meta i
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		instance, e := camService.DescribeGroupMembershipById(ctx, groupId)
-		if e != nil {
-			return retryError(e)
-		}
-		if len(instance) == 0 {
-			return resource.RetryableError(fmt.Errorf("creation not done"))
-		}
-		return nil
-	})
-	if err != nil {
+	if err := camGroupMembershipWaiter(ctx, groupId, camService).Wait(readRetryTimeout); err != nil {
 		log.Printf("[CRITAL]%s read CAM group membership failed, reason:%s\n", logId, err.Error())
 		return err
 	}
-	time.Sleep(10 * time.Second)
 	return resourceTencentCloudCamGroupMembershipRead(d, meta)
 }
 
@@ -172,6 +407,26 @@ func resourceTencentCloudCamGroupMembershipRead(d *schema.ResourceData, meta int
 	}
 	_ = d.Set("group_id", groupId)
 
+	memberGroupIds := d.Get("member_group_ids").(*schema.Set)
+	if memberGroupIds.Len() > 0 {
+		visited := map[string]bool{groupId: true}
+		effective, e := expandEffectiveGroupMembers(ctx, memberGroupIds.List(), visited, camService)
+		if e != nil {
+			log.Printf("[CRITAL]%s expand CAM nested group membership failed, reason:%s\n", logId, e.Error())
+			return e
+		}
+		for _, v := range members {
+			effective = append(effective, *v)
+		}
+		_ = d.Set("effective_user_names", dedupeStrings(effective))
+	} else {
+		direct := make([]string, 0, len(members))
+		for _, v := range members {
+			direct = append(direct, *v)
+		}
+		_ = d.Set("effective_user_names", direct)
+	}
+
 	return nil
 }
 
@@ -186,6 +441,19 @@ func resourceTencentCloudCamGroupMembershipUpdate(d *schema.ResourceData, meta i
 		return err
 	}
 
+	if err := processGroupChange(d, groupId, logId, meta); err != nil {
+		return err
+	}
+
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	camService := CamService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+	if err := camGroupMembershipWaiter(ctx, groupId, camService).Wait(readRetryTimeout); err != nil {
+		log.Printf("[CRITAL]%s read CAM group membership failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
 	return resourceTencentCloudCamGroupMembershipRead(d, meta)
 }
 
@@ -205,6 +473,14 @@ func resourceTencentCloudCamGroupMembershipDelete(d *schema.ResourceData, meta i
 		return err
 	}
 
+	if memberGroupIds := d.Get("member_group_ids").(*schema.Set); memberGroupIds.Len() > 0 {
+		err = removeGroupsFromGroup(memberGroupIds.List(), groupId, nil, meta)
+		if err != nil {
+			log.Printf("[CRITAL]%s delete CAM group failed, reason:%s\n", logId, err.Error())
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -235,43 +511,47 @@ func getUidFromName(name string, meta interface{}) (uid *uint64, errRet error) {
 func addUsersToGroup(members []interface{}, groupId string, meta interface{}) error {
 	logId := getLogId(contextNil)
 
-	request := cam.NewAddUserToGroupRequest()
-	request.Info = make([]*cam.GroupIdOfUidInfo, 0)
-	for _, member := range members {
-		var info cam.GroupIdOfUidInfo
-		//get uid from name
+	lock := camGroupLock(groupId)
+	lock.Lock()
+	defer lock.Unlock()
 
-		uId, e := getUidFromName(member.(string), meta)
-		if e != nil {
-			return e
-		}
-		if uId == nil {
+	groupIdInt, ee := strconv.Atoi(groupId)
+	if ee != nil {
+		return ee
+	}
+	groupIdInt64 := uint64(groupIdInt)
+
+	uids, err := resolveUids(members, meta)
+	if err != nil {
+		return err
+	}
+	info := make([]*cam.GroupIdOfUidInfo, 0, len(uids))
+	for _, uid := range uids {
+		if uid == nil {
 			continue
 		}
-		info.Uid = uId
-		groupIdInt, ee := strconv.Atoi(groupId)
-		if ee != nil {
-			return ee
-		}
-		groupIdInt64 := uint64(groupIdInt)
-		info.GroupId = &groupIdInt64
-		request.Info = append(request.Info, &info)
+		info = append(info, &cam.GroupIdOfUidInfo{GroupId: &groupIdInt64, Uid: uid})
 	}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().AddUserToGroup(request)
-		if e != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), e.Error())
-			return retryError(e)
-		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+
+	for _, batch := range chunkUidInfo(info) {
+		request := cam.NewAddUserToGroupRequest()
+		request.Info = batch
+		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().AddUserToGroup(request)
+			if e != nil {
+				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+					logId, request.GetAction(), request.ToJsonString(), e.Error())
+				return retryError(e)
+			} else {
+				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+					logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			}
+			return nil
+		})
+		if err != nil {
+			log.Printf("[CRITAL]%s create CAM group membership failed, reason:%s\n", logId, err.Error())
+			return err
 		}
-		return nil
-	})
-	if err != nil {
-		log.Printf("[CRITAL]%s create CAM group membership failed, reason:%s\n", logId, err.Error())
-		return err
 	}
 	return nil
 }
@@ -279,54 +559,52 @@ func addUsersToGroup(members []interface{}, groupId string, meta interface{}) er
 func removeUsersFromGroup(members []interface{}, groupId string, meta interface{}) error {
 	logId := getLogId(contextNil)
 
-	request := cam.NewRemoveUserFromGroupRequest()
-	request.Info = make([]*cam.GroupIdOfUidInfo, 0)
-	for _, member := range members {
-		var info cam.GroupIdOfUidInfo
-		uId, e := getUidFromName(member.(string), meta)
-		if e != nil {
-			//notice case when user is deleted, the uin is not found, and the membership is removed in the user module when deleted
-			ee, ok := e.(*errors.TencentCloudSDKError)
-			if !ok {
-				return e
-			}
-			if ee.Code == "ResourceNotFound.UserNotExist" {
-				continue
-			} else {
-				return e
-			}
-		}
-		if uId == nil {
+	lock := camGroupLock(groupId)
+	lock.Lock()
+	defer lock.Unlock()
+
+	groupIdInt, eee := strconv.Atoi(groupId)
+	if eee != nil {
+		return eee
+	}
+	groupIdInt64 := uint64(groupIdInt)
+
+	//notice case when user is deleted, the uin is not found, and the membership is removed in the user module when deleted
+	uids, err := resolveUidsTolerant(members, meta)
+	if err != nil {
+		return err
+	}
+	info := make([]*cam.GroupIdOfUidInfo, 0, len(uids))
+	for _, uid := range uids {
+		if uid == nil {
 			continue
 		}
-		info.Uid = uId
-		groupIdInt, eee := strconv.Atoi(groupId)
-		if eee != nil {
-			return eee
-		}
-		groupIdInt64 := uint64(groupIdInt)
-		info.GroupId = &groupIdInt64
-		request.Info = append(request.Info, &info)
+		info = append(info, &cam.GroupIdOfUidInfo{GroupId: &groupIdInt64, Uid: uid})
 	}
 	//no exist user need to remove, then return
-	if len(request.Info) == 0 {
+	if len(info) == 0 {
 		return nil
 	}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().RemoveUserFromGroup(request)
-		if e != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), e.Error())
-			return retryError(e)
-		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+
+	for _, batch := range chunkUidInfo(info) {
+		request := cam.NewRemoveUserFromGroupRequest()
+		request.Info = batch
+		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().RemoveUserFromGroup(request)
+			if e != nil {
+				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+					logId, request.GetAction(), request.ToJsonString(), e.Error())
+				return retryError(e)
+			} else {
+				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+					logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			}
+			return nil
+		})
+		if err != nil {
+			log.Printf("[CRITAL]%s delete CAM group membership failed, reason:%s\n", logId, err.Error())
+			return err
 		}
-		return nil
-	})
-	if err != nil {
-		log.Printf("[CRITAL]%s delete CAM group membership failed, reason:%s\n", logId, err.Error())
-		return err
 	}
 	return nil
 }
@@ -376,3 +654,188 @@ func processChange(d *schema.ResourceData, groupId string, logId string, meta in
 	}
 	return nil
 }
+
+func processGroupChange(d *schema.ResourceData, groupId string, logId string, meta interface{}) error {
+	if !d.HasChange("member_group_ids") {
+		return nil
+	}
+
+	o, n := d.GetChange("member_group_ids")
+	os := o.(*schema.Set)
+	ns := n.(*schema.Set)
+	add := ns.Difference(os).List()
+	remove := os.Difference(ns).List()
+
+	if len(remove) > 0 {
+		// A user being flattened out of a detached child group may still
+		// belong to this group directly, or through a child group that
+		// stays nested — don't revoke those.
+		keep, kErr := groupMembershipKeepSet(d, ns, meta)
+		if kErr != nil {
+			log.Printf("[CRITAL]%s update CAM group membership failed, reason:%s\n", logId, kErr.Error())
+			return kErr
+		}
+		if oErr := removeGroupsFromGroup(remove, groupId, keep, meta); oErr != nil {
+			log.Printf("[CRITAL]%s update CAM group membership failed, reason:%s\n", logId, oErr.Error())
+			return oErr
+		}
+	}
+	if len(add) > 0 {
+		if nErr := addGroupsToGroup(add, groupId, meta); nErr != nil {
+			log.Printf("[CRITAL]%s update CAM group membership failed, reason:%s\n", logId, nErr.Error())
+			return nErr
+		}
+	}
+	return nil
+}
+
+// groupMembershipKeepSet returns the user names that must stay in groupId
+// regardless of any member_group_ids being detached: this resource's own
+// declared direct members, plus the current members of every group in
+// remainingGroupIds (the nested groups that aren't being removed).
+func groupMembershipKeepSet(d *schema.ResourceData, remainingGroupIds *schema.Set, meta interface{}) (map[string]bool, error) {
+	keep := map[string]bool{}
+
+	directMembers, _, err := getUserIds(d)
+	if err == nil {
+		for _, v := range directMembers.List() {
+			keep[v.(string)] = true
+		}
+	}
+
+	if remainingGroupIds.Len() == 0 {
+		return keep, nil
+	}
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	camService := CamService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+
+	for _, groupId := range remainingGroupIds.List() {
+		members, err := camService.DescribeGroupMembershipById(ctx, groupId.(string))
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range members {
+			keep[*v] = true
+		}
+	}
+	return keep, nil
+}
+
+// addGroupsToGroup nests childGroupIds as members of parentGroupId. CAM has no
+// native group-in-group membership API (unlike AWS IAM's AddUserToGroup-style
+// nesting), so nesting is faked client-side: each child group's current direct
+// members are resolved and added to the parent group through the same
+// AddUserToGroup path addUsersToGroup already uses.
+func addGroupsToGroup(childGroupIds []interface{}, parentGroupId string, meta interface{}) error {
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	camService := CamService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+
+	for _, childGroupId := range childGroupIds {
+		gid := childGroupId.(string)
+		members, err := camService.DescribeGroupMembershipById(ctx, gid)
+		if err != nil {
+			log.Printf("[CRITAL]%s resolve nested CAM group %s members failed, reason:%s\n", logId, gid, err.Error())
+			return err
+		}
+		if len(members) == 0 {
+			continue
+		}
+		childMembers := make([]interface{}, 0, len(members))
+		for _, v := range members {
+			childMembers = append(childMembers, *v)
+		}
+		if err := addUsersToGroup(childMembers, parentGroupId, meta); err != nil {
+			log.Printf("[CRITAL]%s nest CAM group into group failed, reason:%s\n", logId, err.Error())
+			return err
+		}
+	}
+	return nil
+}
+
+// removeGroupsFromGroup detaches the members of childGroupIds from parentGroupId,
+// undoing addGroupsToGroup. Any member name present in keep is left alone even if
+// it's a member of a detached child group, since it's still owed membership
+// through parentGroupId's own direct members or another nested group that isn't
+// being removed; pass nil to remove every resolved member unconditionally (e.g.
+// on resource Delete, where parentGroupId's membership is being torn down
+// entirely).
+func removeGroupsFromGroup(childGroupIds []interface{}, parentGroupId string, keep map[string]bool, meta interface{}) error {
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	camService := CamService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+
+	for _, childGroupId := range childGroupIds {
+		gid := childGroupId.(string)
+		members, err := camService.DescribeGroupMembershipById(ctx, gid)
+		if err != nil {
+			log.Printf("[CRITAL]%s resolve nested CAM group %s members failed, reason:%s\n", logId, gid, err.Error())
+			return err
+		}
+		childMembers := make([]interface{}, 0, len(members))
+		for _, v := range members {
+			if keep[*v] {
+				continue
+			}
+			childMembers = append(childMembers, *v)
+		}
+		if len(childMembers) == 0 {
+			continue
+		}
+		if err := removeUsersFromGroup(childMembers, parentGroupId, meta); err != nil {
+			log.Printf("[CRITAL]%s detach CAM group from group failed, reason:%s\n", logId, err.Error())
+			return err
+		}
+	}
+	return nil
+}
+
+// expandEffectiveGroupMembers resolves the direct user names of groupIds. CAM
+// exposes no server-side notion of nested groups to query, so only one level of
+// nesting is supported: a groupId here is resolved to its current direct
+// members and nothing deeper, which is already the right flattened closure
+// because addGroupsToGroup copies a nested group's members into the real parent
+// group membership at apply time. A groupId already in visited is skipped and
+// every resolved groupId is added to it; the resource read path seeds visited
+// with the parent group id, so a group listed in its own member_group_ids is
+// not resolved.
+func expandEffectiveGroupMembers(ctx context.Context, groupIds []interface{}, visited map[string]bool, camService CamService) ([]string, error) {
+	var effective []string
+	for _, groupId := range groupIds {
+		gid := groupId.(string)
+		if visited[gid] {
+			continue
+		}
+		visited[gid] = true
+
+		members, err := camService.DescribeGroupMembershipById(ctx, gid)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range members {
+			effective = append(effective, *v)
+		}
+	}
+	return effective, nil
+}
+
+func dedupeStrings(in []string) []string {
+	seen := make(map[string]bool, len(in))
+	out := make([]string, 0, len(in))
+	for _, v := range in {
+		if seen[v] {
+			continue
+		}
+		seen[v] = true
+		out = append(out, v)
+	}
+	return out
+}
@@ -0,0 +1,59 @@
+package tencentcloud
+
+import (
+	"testing"
+
+	cam "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cam/v20190116"
+)
+
+func TestChunkUidInfoEmpty(t *testing.T) {
+	if chunks := chunkUidInfo(nil); chunks != nil {
+		t.Fatalf("expected nil chunks for empty input, got %v", chunks)
+	}
+}
+
+func TestChunkUidInfoSplitsIntoBatchSize(t *testing.T) {
+	old := camMembershipBatchSize
+	camMembershipBatchSize = 2
+	defer func() { camMembershipBatchSize = old }()
+
+	groupId := uint64(1)
+	info := make([]*cam.GroupIdOfUidInfo, 0, 5)
+	for i := uint64(0); i < 5; i++ {
+		uid := i
+		info = append(info, &cam.GroupIdOfUidInfo{GroupId: &groupId, Uid: &uid})
+	}
+
+	chunks := chunkUidInfo(info)
+	if len(chunks) != 3 {
+		t.Fatalf("expected 3 chunks of size <= 2 for 5 entries, got %d", len(chunks))
+	}
+	if len(chunks[0]) != 2 || len(chunks[1]) != 2 || len(chunks[2]) != 1 {
+		t.Fatalf("unexpected chunk sizes: %d, %d, %d", len(chunks[0]), len(chunks[1]), len(chunks[2]))
+	}
+
+	var seen []uint64
+	for _, chunk := range chunks {
+		for _, v := range chunk {
+			seen = append(seen, *v.Uid)
+		}
+	}
+	if len(seen) != 5 {
+		t.Fatalf("expected all 5 entries preserved across chunks, got %d", len(seen))
+	}
+}
+
+func TestChunkUidInfoFallsBackToDefaultOnInvalidSize(t *testing.T) {
+	old := camMembershipBatchSize
+	camMembershipBatchSize = 0
+	defer func() { camMembershipBatchSize = old }()
+
+	groupId := uint64(1)
+	uid := uint64(1)
+	info := []*cam.GroupIdOfUidInfo{{GroupId: &groupId, Uid: &uid}}
+
+	chunks := chunkUidInfo(info)
+	if len(chunks) != 1 || len(chunks[0]) != 1 {
+		t.Fatalf("expected a single chunk with 1 entry, got %v", chunks)
+	}
+}
@@ -0,0 +1,420 @@
+/*
+Provides a resource to create a CAM policy binding, attaching a policy to a user,
+group, or role behind one schema instead of a dedicated resource per subject type.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_cam_policy_binding" "foo" {
+  subject_type = "group"
+  subject_id   = tencentcloud_cam_group.foo.name
+  policy_id    = tencentcloud_cam_policy.foo.id
+}
+```
+
+Import
+
+CAM policy binding can be imported using the id, which joins
+`subject_type#subject_id#policy_id` with `#`, e.g.
+
+```
+$ terraform import tencentcloud_cam_policy_binding.foo group#foo#12345678
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	cam "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cam/v20190116"
+)
+
+// camPolicyBindingIdSeparator joins the composite resource id. CAM user/group/role
+// names allow hyphens, so a plain "-" join is ambiguous to split back apart (see
+// parseCamPolicyBindingId); "#" isn't a valid character in those names, so it's
+// used instead.
+const camPolicyBindingIdSeparator = "#"
+
+// resourceTencentCloudCamPolicyBinding is not yet wired into Provider's
+// ResourcesMap (provider.go is not part of this change); add
+// `"tencentcloud_cam_policy_binding": resourceTencentCloudCamPolicyBinding()`
+// there before this resource is reachable from a real config.
+func resourceTencentCloudCamPolicyBinding() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudCamPolicyBindingCreate,
+		Read:   resourceTencentCloudCamPolicyBindingRead,
+		Delete: resourceTencentCloudCamPolicyBindingDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"subject_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errs []error) {
+					switch v.(string) {
+					case "user", "group", "role":
+						return
+					}
+					errs = append(errs, fmt.Errorf("%q must be one of user, group or role, got %q", k, v.(string)))
+					return
+				},
+				Description: "Type of the subject the policy is attached to. Valid values: `user`, `group`, `role`.",
+			},
+			"subject_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the CAM user, group, or role the policy is attached to, depending on `subject_type`.",
+			},
+			"policy_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the CAM policy to attach.",
+			},
+			"condition": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Context key the condition matches against, e.g. `qcs:ip`.",
+						},
+						"operator": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "StringEquals",
+							Description: "Condition operator, e.g. `StringEquals`, `IpEqual`. Defaults to `StringEquals`.",
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Description: "Values the context key is compared against.",
+						},
+					},
+				},
+				Description: "Optional context match recorded alongside the binding for audit purposes. CAM's Attach*Policy APIs do not accept a condition parameter, so this is not enforced by the platform; use policy documents for enforced conditions.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudCamPolicyBindingCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_cam_policy_binding.create")()
+
+	logId := getLogId(contextNil)
+
+	subjectType := d.Get("subject_type").(string)
+	subjectId := d.Get("subject_id").(string)
+	policyId := d.Get("policy_id").(string)
+
+	if strings.Contains(subjectId, camPolicyBindingIdSeparator) {
+		return fmt.Errorf("subject_id %q must not contain %q, which is reserved as the resource id separator", subjectId, camPolicyBindingIdSeparator)
+	}
+
+	if err := attachPolicyToSubject(subjectType, subjectId, policyId, meta); err != nil {
+		log.Printf("[CRITAL]%s create CAM policy binding failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	d.SetId(strings.Join([]string{subjectType, subjectId, policyId}, camPolicyBindingIdSeparator))
+
+	return resourceTencentCloudCamPolicyBindingRead(d, meta)
+}
+
+func resourceTencentCloudCamPolicyBindingRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_cam_policy_binding.read")()
+	defer inconsistentCheck(d, meta)()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	subjectType, subjectId, policyId, err := parseCamPolicyBindingId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	camService := CamService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+
+	var attached bool
+	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		result, e := camService.DescribeAttachedPolicyOfSubject(ctx, subjectType, subjectId, policyId)
+		if e != nil {
+			return retryError(e)
+		}
+		attached = result
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s read CAM policy binding failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	if !attached {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("subject_type", subjectType)
+	_ = d.Set("subject_id", subjectId)
+	_ = d.Set("policy_id", policyId)
+
+	return nil
+}
+
+func resourceTencentCloudCamPolicyBindingDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_cam_policy_binding.delete")()
+
+	logId := getLogId(contextNil)
+
+	subjectType, subjectId, policyId, err := parseCamPolicyBindingId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := detachPolicyFromSubject(subjectType, subjectId, policyId, meta); err != nil {
+		log.Printf("[CRITAL]%s delete CAM policy binding failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func parseCamPolicyBindingId(id string) (subjectType, subjectId, policyId string, errRet error) {
+	parts := strings.Split(id, camPolicyBindingIdSeparator)
+	if len(parts) != 3 {
+		errRet = fmt.Errorf("id %q is not of the form subject_type%ssubject_id%spolicy_id", id, camPolicyBindingIdSeparator, camPolicyBindingIdSeparator)
+		return
+	}
+	subjectType, subjectId, policyId = parts[0], parts[1], parts[2]
+	return
+}
+
+// getGroupIdByName resolves a CAM group name to its numeric group id, mirroring
+// getUidFromName in resource_tc_cam_group_membership.go.
+func getGroupIdByName(name string, meta interface{}) (groupId *string, errRet error) {
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	camService := CamService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		result, e := camService.DescribeGroupByName(ctx, name)
+		if e != nil {
+			return retryError(e)
+		}
+		if result == nil {
+			return nil
+		}
+		groupId = result
+		return nil
+	})
+	if err != nil {
+		errRet = err
+	}
+	return
+}
+
+// getRoleIdByName resolves a CAM role name to its role id, mirroring getUidFromName.
+func getRoleIdByName(name string, meta interface{}) (roleId *string, errRet error) {
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	camService := CamService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		result, e := camService.DescribeRoleByName(ctx, name)
+		if e != nil {
+			return retryError(e)
+		}
+		if result == nil {
+			return nil
+		}
+		roleId = result
+		return nil
+	})
+	if err != nil {
+		errRet = err
+	}
+	return
+}
+
+func attachPolicyToSubject(subjectType, subjectId, policyId string, meta interface{}) error {
+	logId := getLogId(contextNil)
+
+	policyIdUint64, err := strconv.ParseUint(policyId, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	switch subjectType {
+	case "user":
+		uid, e := getUidFromName(subjectId, meta)
+		if e != nil {
+			return e
+		}
+		if uid == nil {
+			return fmt.Errorf("user %q does not exist", subjectId)
+		}
+		request := cam.NewAttachUserPolicyRequest()
+		request.AttachUin = uid
+		request.PolicyId = &policyIdUint64
+		return resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().AttachUserPolicy(request)
+			if e != nil {
+				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+					logId, request.GetAction(), request.ToJsonString(), e.Error())
+				return retryError(e)
+			}
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			return nil
+		})
+	case "group":
+		groupId, e := getGroupIdByName(subjectId, meta)
+		if e != nil {
+			return e
+		}
+		if groupId == nil {
+			return fmt.Errorf("group %q does not exist", subjectId)
+		}
+		request := cam.NewAttachGroupPolicyRequest()
+		request.AttachGroupId = groupId
+		request.PolicyId = &policyIdUint64
+		return resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().AttachGroupPolicy(request)
+			if e != nil {
+				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+					logId, request.GetAction(), request.ToJsonString(), e.Error())
+				return retryError(e)
+			}
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			return nil
+		})
+	case "role":
+		roleId, e := getRoleIdByName(subjectId, meta)
+		if e != nil {
+			return e
+		}
+		if roleId == nil {
+			return fmt.Errorf("role %q does not exist", subjectId)
+		}
+		request := cam.NewAttachRolePolicyRequest()
+		request.AttachRoleId = roleId
+		request.PolicyId = &policyIdUint64
+		return resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().AttachRolePolicy(request)
+			if e != nil {
+				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+					logId, request.GetAction(), request.ToJsonString(), e.Error())
+				return retryError(e)
+			}
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			return nil
+		})
+	default:
+		return fmt.Errorf("unsupported subject_type %q", subjectType)
+	}
+}
+
+func detachPolicyFromSubject(subjectType, subjectId, policyId string, meta interface{}) error {
+	logId := getLogId(contextNil)
+
+	policyIdUint64, err := strconv.ParseUint(policyId, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	switch subjectType {
+	case "user":
+		uid, e := getUidFromName(subjectId, meta)
+		if e != nil {
+			return e
+		}
+		if uid == nil {
+			return nil
+		}
+		request := cam.NewDetachUserPolicyRequest()
+		request.DetachUin = uid
+		request.PolicyId = &policyIdUint64
+		return resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().DetachUserPolicy(request)
+			if e != nil {
+				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+					logId, request.GetAction(), request.ToJsonString(), e.Error())
+				return retryError(e)
+			}
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			return nil
+		})
+	case "group":
+		groupId, e := getGroupIdByName(subjectId, meta)
+		if e != nil {
+			return e
+		}
+		if groupId == nil {
+			return nil
+		}
+		request := cam.NewDetachGroupPolicyRequest()
+		request.DetachGroupId = groupId
+		request.PolicyId = &policyIdUint64
+		return resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().DetachGroupPolicy(request)
+			if e != nil {
+				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+					logId, request.GetAction(), request.ToJsonString(), e.Error())
+				return retryError(e)
+			}
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			return nil
+		})
+	case "role":
+		roleId, e := getRoleIdByName(subjectId, meta)
+		if e != nil {
+			return e
+		}
+		if roleId == nil {
+			return nil
+		}
+		request := cam.NewDetachRolePolicyRequest()
+		request.DetachRoleId = roleId
+		request.PolicyId = &policyIdUint64
+		return resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().DetachRolePolicy(request)
+			if e != nil {
+				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+					logId, request.GetAction(), request.ToJsonString(), e.Error())
+				return retryError(e)
+			}
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			return nil
+		})
+	default:
+		return fmt.Errorf("unsupported subject_type %q", subjectType)
+	}
+}
@@ -0,0 +1,37 @@
+package tencentcloud
+
+import "testing"
+
+func TestParseCamPolicyBindingIdRoundTrip(t *testing.T) {
+	cases := []struct {
+		subjectType string
+		subjectId   string
+		policyId    string
+	}{
+		{"user", "alice", "12345678"},
+		{"group", "developers-team", "12345678"},
+		{"role", "QCS_Role-ci-bot", "98765"},
+	}
+
+	for _, c := range cases {
+		id := c.subjectType + camPolicyBindingIdSeparator + c.subjectId + camPolicyBindingIdSeparator + c.policyId
+
+		subjectType, subjectId, policyId, err := parseCamPolicyBindingId(id)
+		if err != nil {
+			t.Fatalf("parseCamPolicyBindingId(%q) returned error: %s", id, err)
+		}
+		if subjectType != c.subjectType || subjectId != c.subjectId || policyId != c.policyId {
+			t.Fatalf("parseCamPolicyBindingId(%q) = (%q, %q, %q), want (%q, %q, %q)",
+				id, subjectType, subjectId, policyId, c.subjectType, c.subjectId, c.policyId)
+		}
+	}
+}
+
+func TestParseCamPolicyBindingIdRejectsMalformedId(t *testing.T) {
+	if _, _, _, err := parseCamPolicyBindingId("group-foo-12345678"); err == nil {
+		t.Fatal("expected an error for a dash-joined id with no '#' separators")
+	}
+	if _, _, _, err := parseCamPolicyBindingId("group#foo"); err == nil {
+		t.Fatal("expected an error for an id missing the policy_id segment")
+	}
+}
@@ -0,0 +1,249 @@
+/*
+Provides a resource to create a RUM offline log config.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_rum_offline_log_config" "config" {
+  project_key   = "ZEYrYfvaYQ30jRdmPx"
+  unique_id_set = ["100000222201", "100000222202"]
+}
+```
+
+Import
+
+RUM offline log config can be imported using the project_key, e.g.
+
+```
+$ terraform import tencentcloud_rum_offline_log_config.config ZEYrYfvaYQ30jRdmPx
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	rum "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/rum/v20210622"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
+)
+
+// resourceTencentCloudRumOfflineLogConfig is not yet wired into Provider's
+// ResourcesMap (provider.go is not part of this change); add
+// `"tencentcloud_rum_offline_log_config": resourceTencentCloudRumOfflineLogConfig()`
+// there before this resource is reachable from a real config.
+func resourceTencentCloudRumOfflineLogConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudRumOfflineLogConfigCreate,
+		Read:   resourceTencentCloudRumOfflineLogConfigRead,
+		Update: resourceTencentCloudRumOfflineLogConfigUpdate,
+		Delete: resourceTencentCloudRumOfflineLogConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Unique project key for reporting.",
+			},
+			"unique_id_set": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Unique identifier of the users to be listened on (aid or uin). Diffs against the API-returned set reconcile by adding/removing only the changed ids, not replacing the whole listener set.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudRumOfflineLogConfigCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_rum_offline_log_config.create")()
+
+	logId := getLogId(contextNil)
+
+	projectKey := d.Get("project_key").(string)
+
+	request := rum.NewCreateOfflineLogConfigRequest()
+	request.ProjectKey = &projectKey
+	if v, ok := d.GetOk("unique_id_set"); ok {
+		for _, id := range v.(*schema.Set).List() {
+			idStr := id.(string)
+			request.UniqueIDSet = append(request.UniqueIDSet, &idStr)
+		}
+	}
+
+	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRumClient().CreateOfflineLogConfig(request)
+		if e != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e)
+		}
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s create Rum offline log config failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+	d.SetId(projectKey)
+
+	return resourceTencentCloudRumOfflineLogConfigRead(d, meta)
+}
+
+func resourceTencentCloudRumOfflineLogConfigRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_rum_offline_log_config.read")()
+	defer inconsistentCheck(d, meta)()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	projectKey := d.Id()
+	paramMap := map[string]interface{}{
+		"project_key": helper.String(projectKey),
+	}
+	rumService := RumService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	var logConfigs *rum.DescribeOfflineLogConfigsResponseParams
+	w := &waiter.AsyncOperationWaiter{
+		Service: "rum",
+		Type:    "offline_log_config",
+		Op:      "read",
+		Pending: []string{waiter.StatePending},
+		Target:  []string{waiter.StateDone},
+		Refresh: func() (string, error) {
+			results, e := rumService.DescribeRumOfflineLogConfigByFilter(ctx, paramMap)
+			if e != nil {
+				return "", e
+			}
+			logConfigs = results
+			if logConfigs == nil {
+				return waiter.StatePending, nil
+			}
+			return waiter.StateDone, nil
+		},
+	}
+	if err := w.Wait(readRetryTimeout); err != nil {
+		log.Printf("[CRITAL]%s read Rum offline log config failed, reason:%+v", logId, err)
+		return err
+	}
+
+	if logConfigs == nil {
+		d.SetId("")
+		return nil
+	}
+
+	var uniqueID []string
+	if len(logConfigs.UniqueIDSet) > 0 {
+		for _, v := range logConfigs.UniqueIDSet {
+			uniqueID = append(uniqueID, *v)
+		}
+	}
+	_ = d.Set("project_key", projectKey)
+	_ = d.Set("unique_id_set", uniqueID)
+
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if e := writeToFile(output.(string), map[string]interface{}{
+			"project_key":   projectKey,
+			"unique_id_set": uniqueID,
+		}); e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+func resourceTencentCloudRumOfflineLogConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_rum_offline_log_config.update")()
+
+	logId := getLogId(contextNil)
+	projectKey := d.Id()
+
+	if !d.HasChange("unique_id_set") {
+		return resourceTencentCloudRumOfflineLogConfigRead(d, meta)
+	}
+
+	o, n := d.GetChange("unique_id_set")
+	os := o.(*schema.Set)
+	ns := n.(*schema.Set)
+	add := ns.Difference(os).List()
+	remove := os.Difference(ns).List()
+
+	if len(add) == 0 && len(remove) == 0 {
+		return resourceTencentCloudRumOfflineLogConfigRead(d, meta)
+	}
+
+	request := rum.NewModifyOfflineLogConfigRequest()
+	request.ProjectKey = &projectKey
+	for _, id := range add {
+		idStr := id.(string)
+		request.AddUniqueIDSet = append(request.AddUniqueIDSet, &idStr)
+	}
+	for _, id := range remove {
+		idStr := id.(string)
+		request.DeleteUniqueIDSet = append(request.DeleteUniqueIDSet, &idStr)
+	}
+
+	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRumClient().ModifyOfflineLogConfig(request)
+		if e != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e)
+		}
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s update Rum offline log config failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	return resourceTencentCloudRumOfflineLogConfigRead(d, meta)
+}
+
+func resourceTencentCloudRumOfflineLogConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_rum_offline_log_config.delete")()
+
+	logId := getLogId(contextNil)
+	projectKey := d.Id()
+
+	request := rum.NewDeleteOfflineLogConfigRequest()
+	request.ProjectKey = &projectKey
+
+	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRumClient().DeleteOfflineLogConfig(request)
+		if e != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e)
+		}
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s delete Rum offline log config failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	return nil
+}
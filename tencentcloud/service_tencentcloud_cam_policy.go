@@ -0,0 +1,284 @@
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	cam "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cam/v20190116"
+)
+
+// CamAttachedPolicy is a normalized (id, name) pair returned by the
+// List*Policies family of describe calls below, used by both
+// tencentcloud_cam_policy_binding and tencentcloud_cam_effective_policies.
+type CamAttachedPolicy struct {
+	PolicyId   string
+	PolicyName string
+}
+
+func stringOrEmpty(v *string) string {
+	if v == nil {
+		return ""
+	}
+	return *v
+}
+
+// DescribeGroupByName resolves a CAM group name to its numeric group id via
+// ListGroups, mirroring DescribeUserById's name-based lookup for users.
+func (me *CamService) DescribeGroupByName(ctx context.Context, name string) (groupId *string, errRet error) {
+	logId := getLogId(ctx)
+
+	request := cam.NewListGroupsRequest()
+	request.Keyword = &name
+
+	response, err := me.client.UseCamClient().ListGroups(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	if response.Response == nil {
+		return
+	}
+	for _, group := range response.Response.GroupInfo {
+		if group.GroupName != nil && *group.GroupName == name && group.GroupId != nil {
+			id := strconv.FormatUint(*group.GroupId, 10)
+			groupId = &id
+			return
+		}
+	}
+	return
+}
+
+// DescribeRoleByName resolves a CAM role name to its role id via GetRole.
+func (me *CamService) DescribeRoleByName(ctx context.Context, name string) (roleId *string, errRet error) {
+	logId := getLogId(ctx)
+
+	request := cam.NewGetRoleRequest()
+	request.RoleName = &name
+
+	response, err := me.client.UseCamClient().GetRole(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	if response.Response == nil || response.Response.RoleInfo == nil || response.Response.RoleInfo.RoleId == nil {
+		return
+	}
+	roleId = response.Response.RoleInfo.RoleId
+	return
+}
+
+// DescribeAttachedUserPolicies lists the policies attached directly to the CAM
+// user named userName.
+func (me *CamService) DescribeAttachedUserPolicies(ctx context.Context, userName string) (policies []*CamAttachedPolicy, errRet error) {
+	logId := getLogId(ctx)
+
+	userResp, err := me.DescribeUserById(ctx, userName)
+	if err != nil {
+		errRet = err
+		return
+	}
+	if userResp == nil || userResp.Response == nil || userResp.Response.Uid == nil {
+		return
+	}
+
+	rp := uint64(200)
+	page := uint64(1)
+	request := cam.NewListAttachedUserPoliciesRequest()
+	request.TargetUin = userResp.Response.Uid
+	request.Rp = &rp
+	request.Page = &page
+
+	response, err := me.client.UseCamClient().ListAttachedUserPolicies(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	if response.Response == nil {
+		return
+	}
+	for _, p := range response.Response.List {
+		if p.PolicyId == nil {
+			continue
+		}
+		policies = append(policies, &CamAttachedPolicy{
+			PolicyId:   strconv.FormatUint(*p.PolicyId, 10),
+			PolicyName: stringOrEmpty(p.PolicyName),
+		})
+	}
+	return
+}
+
+// DescribeGroupsForUser lists the ids of the CAM groups userName belongs to.
+func (me *CamService) DescribeGroupsForUser(ctx context.Context, userName string) (groupIds []*string, errRet error) {
+	logId := getLogId(ctx)
+
+	userResp, err := me.DescribeUserById(ctx, userName)
+	if err != nil {
+		errRet = err
+		return
+	}
+	if userResp == nil || userResp.Response == nil || userResp.Response.Uid == nil {
+		return
+	}
+
+	request := cam.NewListGroupsForUserRequest()
+	request.Uin = userResp.Response.Uid
+
+	response, err := me.client.UseCamClient().ListGroupsForUser(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	if response.Response == nil {
+		return
+	}
+	for _, g := range response.Response.GroupInfo {
+		if g.GroupId == nil {
+			continue
+		}
+		id := strconv.FormatUint(*g.GroupId, 10)
+		groupIds = append(groupIds, &id)
+	}
+	return
+}
+
+// DescribeAttachedGroupPolicies lists the policies attached directly to the
+// CAM group identified by groupId.
+func (me *CamService) DescribeAttachedGroupPolicies(ctx context.Context, groupId string) (policies []*CamAttachedPolicy, errRet error) {
+	logId := getLogId(ctx)
+
+	groupIdUint, err := strconv.ParseUint(groupId, 10, 64)
+	if err != nil {
+		errRet = err
+		return
+	}
+
+	rp := uint64(200)
+	page := uint64(1)
+	request := cam.NewListAttachedGroupPoliciesRequest()
+	request.TargetGroupId = &groupIdUint
+	request.Rp = &rp
+	request.Page = &page
+
+	response, err := me.client.UseCamClient().ListAttachedGroupPolicies(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	if response.Response == nil {
+		return
+	}
+	for _, p := range response.Response.List {
+		if p.PolicyId == nil {
+			continue
+		}
+		policies = append(policies, &CamAttachedPolicy{
+			PolicyId:   strconv.FormatUint(*p.PolicyId, 10),
+			PolicyName: stringOrEmpty(p.PolicyName),
+		})
+	}
+	return
+}
+
+// DescribeAttachedRolePolicies lists the policies attached directly to the CAM
+// role named roleName.
+func (me *CamService) DescribeAttachedRolePolicies(ctx context.Context, roleName string) (policies []*CamAttachedPolicy, errRet error) {
+	logId := getLogId(ctx)
+
+	rp := uint64(200)
+	page := uint64(1)
+	request := cam.NewListAttachedRolePoliciesRequest()
+	request.AttachRoleName = &roleName
+	request.Rp = &rp
+	request.Page = &page
+
+	response, err := me.client.UseCamClient().ListAttachedRolePolicies(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	if response.Response == nil {
+		return
+	}
+	for _, p := range response.Response.List {
+		if p.PolicyId == nil {
+			continue
+		}
+		policies = append(policies, &CamAttachedPolicy{
+			PolicyId:   strconv.FormatUint(*p.PolicyId, 10),
+			PolicyName: stringOrEmpty(p.PolicyName),
+		})
+	}
+	return
+}
+
+// DescribeAttachedPolicyOfSubject reports whether policyId is currently
+// attached to the given subject. For users and groups subjectId (a name) is
+// resolved the same way attachPolicyToSubject/detachPolicyFromSubject in
+// resource_tc_cam_policy_binding.go do; for roles the name is passed to
+// ListAttachedRolePolicies as is.
+func (me *CamService) DescribeAttachedPolicyOfSubject(ctx context.Context, subjectType, subjectId, policyId string) (attached bool, errRet error) {
+	var policies []*CamAttachedPolicy
+	var err error
+
+	switch subjectType {
+	case "user":
+		policies, err = me.DescribeAttachedUserPolicies(ctx, subjectId)
+	case "group":
+		var groupId *string
+		groupId, err = me.DescribeGroupByName(ctx, subjectId)
+		if err != nil {
+			return false, err
+		}
+		if groupId == nil {
+			return false, nil
+		}
+		policies, err = me.DescribeAttachedGroupPolicies(ctx, *groupId)
+	case "role":
+		policies, err = me.DescribeAttachedRolePolicies(ctx, subjectId)
+	default:
+		return false, fmt.Errorf("unsupported subject_type %q", subjectType)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	for _, p := range policies {
+		if p.PolicyId == policyId {
+			return true, nil
+		}
+	}
+	return false, nil
+}